@@ -0,0 +1,32 @@
+package main
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v10"
+)
+
+// Config is parsed from the process environment on startup.
+type Config struct {
+	Addr         string        `env:"ADDR" envDefault:":8080"`
+	DBFileName   string        `env:"DB_FILE_NAME" envDefault:"db.json"`
+	ReadTimeout  time.Duration `env:"READ_TIMEOUT" envDefault:"5s"`
+	WriteTimeout time.Duration `env:"WRITE_TIMEOUT" envDefault:"10s"`
+
+	StorageBackend string `env:"STORAGE_BACKEND" envDefault:"json"`
+
+	PGHost   string `env:"PG_HOST"`
+	PGPort   string `env:"PG_PORT"`
+	PGUser   string `env:"PG_USER"`
+	PGPwd    string `env:"PG_PWD"`
+	PGDBName string `env:"PG_DB_NAME"`
+}
+
+func loadConfig() (Config, error) {
+	var cfg Config
+	if err := env.Parse(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type Controller struct {
+	repo PersonRepository
+}
+
+func NewController(repo PersonRepository) *Controller {
+	return &Controller{
+		repo: repo,
+	}
+}
+
+// ListResponse is the JSON envelope returned by HandleListPeople.
+type ListResponse struct {
+	Items  []Person `json:"items"`
+	Total  int      `json:"total"`
+	Limit  int      `json:"limit"`
+	Offset int      `json:"offset"`
+}
+
+func (c *Controller) HandleListPeople(w http.ResponseWriter, r *http.Request) {
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_query", err.Error())
+		return
+	}
+
+	items, total, err := c.repo.List(opts)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ListResponse{
+		Items:  items,
+		Total:  total,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+}
+
+func (c *Controller) HandleGetPerson(w http.ResponseWriter, r *http.Request) {
+	id, err := parsePersonID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_id", err.Error())
+		return
+	}
+
+	person, err := c.repo.Get(id)
+	if err != nil {
+		writeRepositoryError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, person)
+}
+
+func (c *Controller) HandleCreatePerson(w http.ResponseWriter, r *http.Request) {
+	var person Person
+	if err := json.NewDecoder(r.Body).Decode(&person); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return
+	}
+
+	if fields := validatePerson(person); len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "person is invalid", fields...)
+		return
+	}
+
+	created, err := c.repo.Create(person)
+	if err != nil {
+		writeRepositoryError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/people/"+created.ID.String())
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (c *Controller) HandleReplacePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := parsePersonID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_id", err.Error())
+		return
+	}
+
+	var person Person
+	if err := json.NewDecoder(r.Body).Decode(&person); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return
+	}
+
+	if fields := validatePerson(person); len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "person is invalid", fields...)
+		return
+	}
+
+	updated, err := c.repo.Update(id, person)
+	if err != nil {
+		writeRepositoryError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (c *Controller) HandlePatchPerson(w http.ResponseWriter, r *http.Request) {
+	id, err := parsePersonID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_id", err.Error())
+		return
+	}
+
+	var patch PersonPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return
+	}
+
+	if fields := patch.ValidateUpdate(); len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "person is invalid", fields...)
+		return
+	}
+
+	updated, err := c.repo.Patch(id, patch)
+	if err != nil {
+		writeRepositoryError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (c *Controller) HandleRemovePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := parsePersonID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_id", err.Error())
+		return
+	}
+
+	if err := c.repo.Delete(id); err != nil {
+		writeRepositoryError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func parsePersonID(r *http.Request) (uuid.UUID, error) {
+	return uuid.Parse(r.PathValue("id"))
+}
+
+// writeRepositoryError maps a PersonRepository error to the HTTP status it
+// represents: ErrPersonNotExit is a 404, anything else is a genuine
+// storage failure.
+func writeRepositoryError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrPersonNotExit) {
+		writeError(w, http.StatusNotFound, "not_found", err.Error())
+		return
+	}
+
+	writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+}
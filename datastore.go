@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Datastore is the JSON-file PersonRepository implementation. It is the
+// default backend and remains available via STORAGE_BACKEND=json for
+// deployments that don't want to run Postgres. mu serializes Get/List
+// reads against Create/Update/Patch/Delete writes so concurrent requests
+// can't race on db.json.
+type Datastore struct {
+	fileName string
+	mu       sync.RWMutex
+}
+
+func NewDatastore(fileName string) *Datastore {
+	return &Datastore{
+		fileName: fileName,
+	}
+}
+
+func (d *Datastore) loadPeople() (map[uuid.UUID]Person, error) {
+	file, err := os.ReadFile(d.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[uuid.UUID]Person), nil
+		}
+
+		return nil, err
+	}
+
+	var people map[uuid.UUID]Person
+	err = json.Unmarshal(file, &people)
+	if err != nil {
+		return nil, err
+	}
+
+	return people, nil
+}
+
+// savePeople writes via a temp file + rename so a reader never observes a
+// partially written db.json.
+func (d *Datastore) savePeople(people map[uuid.UUID]Person) error {
+	data, err := json.MarshalIndent(people, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(d.fileName), filepath.Base(d.fileName)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), d.fileName)
+}
+
+func (d *Datastore) Get(id uuid.UUID) (Person, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return Person{}, err
+	}
+
+	if person, ok := people[id]; ok {
+		return person, nil
+	}
+
+	return Person{}, ErrPersonNotExit
+}
+
+func (d *Datastore) List(opts ListOptions) ([]Person, int, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := make([]Person, 0, len(people))
+	for _, person := range people {
+		if matchesListOptions(person, opts) {
+			filtered = append(filtered, person)
+		}
+	}
+
+	sortPeople(filtered, opts.SortColumn, opts.SortOrder)
+
+	total := len(filtered)
+
+	return paginate(filtered, opts.Limit, opts.Offset), total, nil
+}
+
+func matchesListOptions(person Person, opts ListOptions) bool {
+	if opts.FirstName != "" && !strings.Contains(person.FirstName, opts.FirstName) {
+		return false
+	}
+
+	if opts.SecondName != "" && !strings.Contains(person.SecondName, opts.SecondName) {
+		return false
+	}
+
+	if opts.AgeGT != nil && person.Age <= *opts.AgeGT {
+		return false
+	}
+
+	if opts.AgeLT != nil && person.Age >= *opts.AgeLT {
+		return false
+	}
+
+	return true
+}
+
+// sortPeople sorts in place by column ("firstName", "secondName" or
+// "age"; "" leaves the order untouched). parseListOptions has already
+// rejected any other value.
+func sortPeople(people []Person, column, order string) {
+	var less func(i, j int) bool
+
+	switch column {
+	case "":
+		return
+	case "secondName":
+		less = func(i, j int) bool { return people[i].SecondName < people[j].SecondName }
+	case "age":
+		less = func(i, j int) bool { return people[i].Age < people[j].Age }
+	case "firstName":
+		less = func(i, j int) bool { return people[i].FirstName < people[j].FirstName }
+	}
+
+	if order == "desc" {
+		sort.SliceStable(people, func(i, j int) bool { return less(j, i) })
+		return
+	}
+
+	sort.SliceStable(people, less)
+}
+
+func paginate(people []Person, limit, offset int) []Person {
+	if offset < 0 {
+		offset = 0
+	}
+
+	if offset >= len(people) {
+		return []Person{}
+	}
+
+	people = people[offset:]
+
+	if limit > 0 && limit < len(people) {
+		people = people[:limit]
+	}
+
+	return people
+}
+
+func (d *Datastore) Create(person Person) (Person, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return Person{}, err
+	}
+
+	now := time.Now().UTC()
+	person.ID = uuid.New()
+	person.CreatedAt = now
+	person.UpdatedAt = now
+
+	people[person.ID] = person
+
+	if err := d.savePeople(people); err != nil {
+		return Person{}, err
+	}
+
+	return person, nil
+}
+
+func (d *Datastore) Update(id uuid.UUID, person Person) (Person, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return Person{}, err
+	}
+
+	existing, ok := people[id]
+	if !ok {
+		return Person{}, ErrPersonNotExit
+	}
+
+	person.ID = id
+	person.CreatedAt = existing.CreatedAt
+	person.UpdatedAt = time.Now().UTC()
+
+	people[id] = person
+
+	if err := d.savePeople(people); err != nil {
+		return Person{}, err
+	}
+
+	return person, nil
+}
+
+func (d *Datastore) Patch(id uuid.UUID, patch PersonPatch) (Person, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return Person{}, err
+	}
+
+	existing, ok := people[id]
+	if !ok {
+		return Person{}, ErrPersonNotExit
+	}
+
+	updated := patch.Apply(existing)
+	updated.UpdatedAt = time.Now().UTC()
+
+	people[id] = updated
+
+	if err := d.savePeople(people); err != nil {
+		return Person{}, err
+	}
+
+	return updated, nil
+}
+
+func (d *Datastore) Delete(id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	people, err := d.loadPeople()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := people[id]; !ok {
+		return ErrPersonNotExit
+	}
+
+	delete(people, id)
+
+	if err := d.savePeople(people); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMatchesListOptions(t *testing.T) {
+	person := Person{FirstName: "Jane", SecondName: "Doe", Age: 30}
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want bool
+	}{
+		{"no filters matches", ListOptions{}, true},
+		{"matching firstName substring", ListOptions{FirstName: "Jan"}, true},
+		{"non-matching firstName substring", ListOptions{FirstName: "Bob"}, false},
+		{"matching secondName substring", ListOptions{SecondName: "oe"}, true},
+		{"age above age_gt matches", ListOptions{AgeGT: intPtr(20)}, true},
+		{"age equal to age_gt does not match", ListOptions{AgeGT: intPtr(30)}, false},
+		{"age below age_lt matches", ListOptions{AgeLT: intPtr(40)}, true},
+		{"age equal to age_lt does not match", ListOptions{AgeLT: intPtr(30)}, false},
+		{"all filters satisfied", ListOptions{FirstName: "Jane", SecondName: "Doe", AgeGT: intPtr(29), AgeLT: intPtr(31)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesListOptions(person, tt.opts); got != tt.want {
+				t.Errorf("matchesListOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortPeople(t *testing.T) {
+	unsorted := func() []Person {
+		return []Person{
+			{FirstName: "Carl", SecondName: "Young", Age: 40},
+			{FirstName: "Amy", SecondName: "Old", Age: 20},
+			{FirstName: "Bob", SecondName: "Mid", Age: 30},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		column    string
+		order     string
+		wantFirst []string
+	}{
+		{"no column leaves order untouched", "", "", []string{"Carl", "Amy", "Bob"}},
+		{"firstName ascending", "firstName", "asc", []string{"Amy", "Bob", "Carl"}},
+		{"firstName descending", "firstName", "desc", []string{"Carl", "Bob", "Amy"}},
+		{"secondName ascending", "secondName", "asc", []string{"Bob", "Amy", "Carl"}},
+		{"age ascending", "age", "asc", []string{"Amy", "Bob", "Carl"}},
+		{"age descending", "age", "desc", []string{"Carl", "Bob", "Amy"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			people := unsorted()
+			sortPeople(people, tt.column, tt.order)
+
+			got := make([]string, len(people))
+			for i, p := range people {
+				got[i] = p.FirstName
+			}
+
+			if len(got) != len(tt.wantFirst) {
+				t.Fatalf("sortPeople() returned %d people, want %d", len(got), len(tt.wantFirst))
+			}
+
+			for i := range got {
+				if got[i] != tt.wantFirst[i] {
+					t.Errorf("sortPeople() = %v, want %v", got, tt.wantFirst)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestDatastoreListPagination(t *testing.T) {
+	ds := NewDatastore(filepath.Join(t.TempDir(), "db.json"))
+
+	for _, p := range []Person{
+		{FirstName: "Amy", SecondName: "Old", Age: 20},
+		{FirstName: "Bob", SecondName: "Mid", Age: 30},
+		{FirstName: "Carl", SecondName: "Young", Age: 40},
+	} {
+		if _, err := ds.Create(p); err != nil {
+			t.Fatalf("Create(%+v) error = %v", p, err)
+		}
+	}
+
+	items, total, err := ds.List(ListOptions{SortColumn: "age", SortOrder: "asc", Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if total != 3 {
+		t.Errorf("List() total = %d, want 3", total)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("List() returned %d items, want 2", len(items))
+	}
+
+	if items[0].FirstName != "Bob" || items[1].FirstName != "Carl" {
+		t.Errorf("List() = %v, want [Bob Carl]", items)
+	}
+}
+
+func TestDatastoreListFiltersByAgeRange(t *testing.T) {
+	ds := NewDatastore(filepath.Join(t.TempDir(), "db.json"))
+
+	for _, p := range []Person{
+		{FirstName: "Amy", SecondName: "Old", Age: 20},
+		{FirstName: "Bob", SecondName: "Mid", Age: 30},
+		{FirstName: "Carl", SecondName: "Young", Age: 40},
+	} {
+		if _, err := ds.Create(p); err != nil {
+			t.Fatalf("Create(%+v) error = %v", p, err)
+		}
+	}
+
+	items, total, err := ds.List(ListOptions{AgeGT: intPtr(20), AgeLT: intPtr(40)})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if total != 1 || len(items) != 1 || items[0].FirstName != "Bob" {
+		t.Errorf("List() = %v (total %d), want just [Bob]", items, total)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// swagger.json is maintained by hand alongside the swagger:operation
+// comments in router.go; there is no generator step, so a change to one
+// must be reflected in the other.
+//
+//go:embed swagger.json
+var swaggerSpecFS embed.FS
+
+// swaggerUIFS embeds the vendored swagger-ui-dist assets (bundle JS, CSS,
+// favicons) plus our index.html shell, so /docs/ works with no internet
+// egress.
+//
+//go:embed swaggerui
+var swaggerUIFS embed.FS
+
+func newSwaggerUIHandler() http.Handler {
+	sub, err := fs.Sub(swaggerUIFS, "swaggerui")
+	if err != nil {
+		panic(err)
+	}
+
+	return http.FileServer(http.FS(sub))
+}
+
+// HandleSwaggerSpec serves the OpenAPI spec describing the endpoints
+// registered in router.go.
+func HandleSwaggerSpec(w http.ResponseWriter, r *http.Request) {
+	data, err := swaggerSpecFS.ReadFile("swagger.json")
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// HandleDocsRedirect sends GET /docs to the canonical GET /docs/ so the
+// embedded index.html and its relative asset paths resolve correctly.
+func HandleDocsRedirect(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "/docs/", http.StatusMovedPermanently)
+}
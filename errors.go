@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// fieldError reports a validation failure for a single Person field.
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// apiError is the body of every non-2xx response.
+type apiError struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Fields  []fieldError `json:"fields,omitempty"`
+}
+
+type errorEnvelope struct {
+	Error apiError `json:"error"`
+}
+
+// writeJSON marshals v and writes it with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// writeError writes a structured {"error": {...}} response.
+func writeError(w http.ResponseWriter, status int, code, message string, fields ...fieldError) {
+	writeJSON(w, status, errorEnvelope{
+		Error: apiError{
+			Code:    code,
+			Message: message,
+			Fields:  fields,
+		},
+	})
+}
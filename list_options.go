@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ListOptions carries the pagination, sorting and filtering parameters
+// accepted by GET /people.
+type ListOptions struct {
+	Limit      int
+	Offset     int
+	SortColumn string // firstName|secondName|age
+	SortOrder  string // asc|desc
+
+	FirstName  string
+	SecondName string
+	AgeGT      *int
+	AgeLT      *int
+}
+
+// parseListOptions reads limit/offset/sort_column/sort_order and the
+// firstName/secondName/age_gt/age_lt filters off the request's query
+// string.
+func parseListOptions(r *http.Request) (ListOptions, error) {
+	q := r.URL.Query()
+
+	opts := ListOptions{
+		SortColumn: q.Get("sort_column"),
+		SortOrder:  q.Get("sort_order"),
+		FirstName:  q.Get("firstName"),
+		SecondName: q.Get("secondName"),
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, err
+		}
+		opts.Limit = limit
+	}
+
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, err
+		}
+		opts.Offset = offset
+	}
+
+	if v := q.Get("age_gt"); v != "" {
+		ageGT, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, err
+		}
+		opts.AgeGT = &ageGT
+	}
+
+	if v := q.Get("age_lt"); v != "" {
+		ageLT, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, err
+		}
+		opts.AgeLT = &ageLT
+	}
+
+	switch opts.SortColumn {
+	case "", "firstName", "secondName", "age":
+	default:
+		return ListOptions{}, fmt.Errorf("sort_column must be one of firstName, secondName, age, got %q", opts.SortColumn)
+	}
+
+	switch opts.SortOrder {
+	case "", "asc", "desc":
+	default:
+		return ListOptions{}, fmt.Errorf("sort_order must be one of asc, desc, got %q", opts.SortOrder)
+	}
+
+	return opts, nil
+}
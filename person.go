@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPersonNotExit = errors.New("person does not exist")
+)
+
+type Person struct {
+	ID         uuid.UUID `json:"id"`
+	FirstName  string    `json:"firstName"`
+	SecondName string    `json:"secondName"`
+	Age        int       `json:"age"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// PersonPatch is the PATCH /people/{id} body: every field is optional, and
+// only the ones present are applied to the stored Person.
+type PersonPatch struct {
+	FirstName  *string `json:"firstName,omitempty"`
+	SecondName *string `json:"secondName,omitempty"`
+	Age        *int    `json:"age,omitempty"`
+}
+
+// ValidateUpdate checks the fields that were actually supplied in the
+// patch, mirroring validatePerson's rules for a full Person.
+func (p PersonPatch) ValidateUpdate() []fieldError {
+	var fields []fieldError
+
+	if p.FirstName != nil && *p.FirstName == "" {
+		fields = append(fields, fieldError{Field: "firstName", Message: "must not be empty"})
+	}
+
+	if p.SecondName != nil && *p.SecondName == "" {
+		fields = append(fields, fieldError{Field: "secondName", Message: "must not be empty"})
+	}
+
+	if p.Age != nil && *p.Age < 0 {
+		fields = append(fields, fieldError{Field: "age", Message: "must not be negative"})
+	}
+
+	return fields
+}
+
+// Apply returns person with the non-nil patch fields overlaid.
+func (p PersonPatch) Apply(person Person) Person {
+	if p.FirstName != nil {
+		person.FirstName = *p.FirstName
+	}
+
+	if p.SecondName != nil {
+		person.SecondName = *p.SecondName
+	}
+
+	if p.Age != nil {
+		person.Age = *p.Age
+	}
+
+	return person
+}
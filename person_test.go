@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func strPtr(s string) *string { return &s }
+func intPtr(i int) *int       { return &i }
+
+func TestPersonPatchValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   PersonPatch
+		wantLen int
+	}{
+		{"empty patch is valid", PersonPatch{}, 0},
+		{"non-empty fields are valid", PersonPatch{FirstName: strPtr("Jane"), Age: intPtr(5)}, 0},
+		{"empty firstName is invalid", PersonPatch{FirstName: strPtr("")}, 1},
+		{"empty secondName is invalid", PersonPatch{SecondName: strPtr("")}, 1},
+		{"negative age is invalid", PersonPatch{Age: intPtr(-1)}, 1},
+		{"all fields invalid at once", PersonPatch{FirstName: strPtr(""), SecondName: strPtr(""), Age: intPtr(-1)}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.patch.ValidateUpdate()
+			if len(got) != tt.wantLen {
+				t.Errorf("ValidateUpdate() = %v, want %d field errors", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestPersonPatchApply(t *testing.T) {
+	base := Person{FirstName: "Jane", SecondName: "Doe", Age: 30}
+
+	tests := []struct {
+		name  string
+		patch PersonPatch
+		want  Person
+	}{
+		{"no fields leaves person unchanged", PersonPatch{}, base},
+		{
+			"firstName only",
+			PersonPatch{FirstName: strPtr("Janet")},
+			Person{FirstName: "Janet", SecondName: "Doe", Age: 30},
+		},
+		{
+			"all fields",
+			PersonPatch{FirstName: strPtr("Janet"), SecondName: strPtr("Smith"), Age: intPtr(31)},
+			Person{FirstName: "Janet", SecondName: "Smith", Age: 31},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.patch.Apply(base)
+			if got.FirstName != tt.want.FirstName || got.SecondName != tt.want.SecondName || got.Age != tt.want.Age {
+				t.Errorf("Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// personRecord is the GORM row mapping for the "people" table. CreatedAt
+// and UpdatedAt are populated automatically by GORM's convention for
+// fields with those exact names.
+type personRecord struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid"`
+	FirstName  string
+	SecondName string
+	Age        int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (personRecord) TableName() string {
+	return "people"
+}
+
+func (r *personRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+
+	return nil
+}
+
+func (r personRecord) toPerson() Person {
+	return Person{
+		ID:         r.ID,
+		FirstName:  r.FirstName,
+		SecondName: r.SecondName,
+		Age:        r.Age,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// PostgresRepository is the GORM/Postgres PersonRepository implementation,
+// selected via STORAGE_BACKEND=postgres and configured through the
+// PG_HOST/PG_PORT/PG_USER/PG_PWD/PG_DB_NAME env vars.
+type PostgresRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRepository(cfg Config) (*PostgresRepository, error) {
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		cfg.PGHost,
+		cfg.PGPort,
+		cfg.PGUser,
+		cfg.PGPwd,
+		cfg.PGDBName,
+	)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&personRecord{}); err != nil {
+		return nil, err
+	}
+
+	return &PostgresRepository{db: db}, nil
+}
+
+func (r *PostgresRepository) Get(id uuid.UUID) (Person, error) {
+	var rec personRecord
+	if err := r.db.First(&rec, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Person{}, ErrPersonNotExit
+		}
+
+		return Person{}, err
+	}
+
+	return rec.toPerson(), nil
+}
+
+func (r *PostgresRepository) List(opts ListOptions) ([]Person, int, error) {
+	query := r.db.Model(&personRecord{})
+
+	if opts.FirstName != "" {
+		query = query.Where("first_name LIKE ?", "%"+opts.FirstName+"%")
+	}
+
+	if opts.SecondName != "" {
+		query = query.Where("second_name LIKE ?", "%"+opts.SecondName+"%")
+	}
+
+	if opts.AgeGT != nil {
+		query = query.Where("age > ?", *opts.AgeGT)
+	}
+
+	if opts.AgeLT != nil {
+		query = query.Where("age < ?", *opts.AgeLT)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if column := postgresSortColumn(opts.SortColumn); column != "" {
+		order := column
+		if opts.SortOrder == "desc" {
+			order += " desc"
+		}
+		query = query.Order(order)
+	}
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	if opts.Offset > 0 {
+		query = query.Offset(opts.Offset)
+	}
+
+	var recs []personRecord
+	if err := query.Find(&recs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	people := make([]Person, 0, len(recs))
+	for _, rec := range recs {
+		people = append(people, rec.toPerson())
+	}
+
+	return people, int(total), nil
+}
+
+// postgresSortColumn maps a validated ListOptions.SortColumn ("", "firstName",
+// "secondName" or "age" — parseListOptions rejects anything else) to its
+// column name in the people table.
+func postgresSortColumn(column string) string {
+	switch column {
+	case "secondName":
+		return "second_name"
+	case "age":
+		return "age"
+	case "firstName":
+		return "first_name"
+	default:
+		return ""
+	}
+}
+
+func (r *PostgresRepository) Create(person Person) (Person, error) {
+	rec := personRecord{
+		FirstName:  person.FirstName,
+		SecondName: person.SecondName,
+		Age:        person.Age,
+	}
+
+	if err := r.db.Create(&rec).Error; err != nil {
+		return Person{}, err
+	}
+
+	return rec.toPerson(), nil
+}
+
+func (r *PostgresRepository) Update(id uuid.UUID, person Person) (Person, error) {
+	var rec personRecord
+	if err := r.db.First(&rec, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Person{}, ErrPersonNotExit
+		}
+
+		return Person{}, err
+	}
+
+	rec.FirstName = person.FirstName
+	rec.SecondName = person.SecondName
+	rec.Age = person.Age
+
+	if err := r.db.Save(&rec).Error; err != nil {
+		return Person{}, err
+	}
+
+	return rec.toPerson(), nil
+}
+
+func (r *PostgresRepository) Patch(id uuid.UUID, patch PersonPatch) (Person, error) {
+	var rec personRecord
+	if err := r.db.First(&rec, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Person{}, ErrPersonNotExit
+		}
+
+		return Person{}, err
+	}
+
+	updated := patch.Apply(rec.toPerson())
+	rec.FirstName = updated.FirstName
+	rec.SecondName = updated.SecondName
+	rec.Age = updated.Age
+
+	if err := r.db.Save(&rec).Error; err != nil {
+		return Person{}, err
+	}
+
+	return rec.toPerson(), nil
+}
+
+func (r *PostgresRepository) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&personRecord{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrPersonNotExit
+	}
+
+	return nil
+}
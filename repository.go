@@ -0,0 +1,15 @@
+package main
+
+import "github.com/google/uuid"
+
+// PersonRepository abstracts persistence for Person records so the
+// Controller can be tested against an in-memory fake and so the storage
+// backend can be swapped (JSON file, Postgres, ...) via configuration.
+type PersonRepository interface {
+	Get(id uuid.UUID) (Person, error)
+	List(opts ListOptions) (items []Person, total int, err error)
+	Create(person Person) (Person, error)
+	Update(id uuid.UUID, person Person) (Person, error)
+	Patch(id uuid.UUID, patch PersonPatch) (Person, error)
+	Delete(id uuid.UUID) error
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+)
+
+type Router struct {
+	server *http.Server
+}
+
+func NewRouter(cfg Config, pc *Controller) *Router {
+	mux := http.NewServeMux()
+
+	// swagger:operation GET /people listPeople
+	//
+	// Lists persons, with optional pagination, sorting and filters
+	//
+	// any matching persons
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: limit
+	//   in: query
+	//   type: integer
+	// - name: offset
+	//   in: query
+	//   type: integer
+	// - name: sort_column
+	//   in: query
+	//   description: firstName, secondName or age
+	//   type: string
+	// - name: sort_order
+	//   in: query
+	//   description: asc or desc
+	//   type: string
+	// - name: firstName
+	//   in: query
+	//   description: substring filter
+	//   type: string
+	// - name: age_gt
+	//   in: query
+	//   type: integer
+	// - name: age_lt
+	//   in: query
+	//   type: integer
+	// responses:
+	//   '200':
+	//     description: paginated person list
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("GET /people", pc.HandleListPeople)
+
+	// swagger:operation POST /people createPerson
+	//
+	// Creates a person with a server-generated id
+	//
+	// any person
+	//
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '201':
+	//     description: the created person
+	//   '422':
+	//     description: validation error
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("POST /people", pc.HandleCreatePerson)
+
+	// swagger:operation GET /people/{id} getPerson
+	//
+	// Returns a person with sepcified id
+	//
+	// any existing person
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: person id
+	//   required: true
+	//   type: string
+	//   format: uuid
+	// responses:
+	//   '200':
+	//     description: person response
+	//     schema:
+	//       type: object
+	//       properties:
+	//		   firstName:
+	//		     type: integer
+	//		   secondName:
+	//			 type: string
+	//		   age:
+	//			 type: integer
+	//   '404':
+	//     description: person not found
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("GET /people/{id}", pc.HandleGetPerson)
+
+	// swagger:operation PUT /people/{id} replacePerson
+	//
+	// Replaces a person with the specified id
+	//
+	// any person
+	//
+	// ---
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: person id
+	//   required: true
+	//   type: string
+	//   format: uuid
+	// responses:
+	//   '200':
+	//     description: the replaced person
+	//   '404':
+	//     description: person not found
+	//   '422':
+	//     description: validation error
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("PUT /people/{id}", pc.HandleReplacePerson)
+
+	// swagger:operation PATCH /people/{id} patchPerson
+	//
+	// Updates the non-empty fields of a person with the specified id
+	//
+	// the fields to update
+	//
+	// ---
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: person id
+	//   required: true
+	//   type: string
+	//   format: uuid
+	// responses:
+	//   '200':
+	//     description: the updated person
+	//   '404':
+	//     description: person not found
+	//   '422':
+	//     description: validation error
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("PATCH /people/{id}", pc.HandlePatchPerson)
+
+	// swagger:operation DELETE /people/{id} removePerson
+	//
+	// Removes person with sepcified id
+	//
+	// any existing person
+	//
+	// ---
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: person id
+	//   required: true
+	//   type: string
+	//   format: uuid
+	// responses:
+	//   '200':
+	//     description: person response
+	//   '404':
+	//     description: person not found
+	//   '500':
+	//     description: any error
+	mux.HandleFunc("DELETE /people/{id}", pc.HandleRemovePerson)
+
+	// swagger:operation GET /swagger.json getSwaggerSpec
+	//
+	// Returns the OpenAPI spec
+	//
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: the OpenAPI spec
+	mux.HandleFunc("GET /swagger.json", HandleSwaggerSpec)
+
+	// swagger:operation GET /docs/ getSwaggerUI
+	//
+	// Serves an interactive Swagger UI for the API, built from assets
+	// embedded in the binary
+	//
+	// ---
+	// produces:
+	// - text/html
+	// responses:
+	//   '200':
+	//     description: the Swagger UI page
+	mux.HandleFunc("GET /docs", HandleDocsRedirect)
+	mux.Handle("GET /docs/", http.StripPrefix("/docs/", newSwaggerUIHandler()))
+
+	return &Router{
+		server: &http.Server{
+			Addr:         cfg.Addr,
+			Handler:      mux,
+			ReadTimeout:  cfg.ReadTimeout,
+			WriteTimeout: cfg.WriteTimeout,
+		},
+	}
+}
+
+// Run serves until ctx is cancelled, then gracefully shuts the server down.
+func (r *Router) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("Server running %s", r.server.Addr)
+		errCh <- r.server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		return r.server.Shutdown(shutdownCtx)
+	}
+}
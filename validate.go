@@ -0,0 +1,21 @@
+package main
+
+// validatePerson checks the business rules POST/PUT/PATCH must enforce:
+// names must be non-empty and age must not be negative.
+func validatePerson(person Person) []fieldError {
+	var fields []fieldError
+
+	if person.FirstName == "" {
+		fields = append(fields, fieldError{Field: "firstName", Message: "must not be empty"})
+	}
+
+	if person.SecondName == "" {
+		fields = append(fields, fieldError{Field: "secondName", Message: "must not be empty"})
+	}
+
+	if person.Age < 0 {
+		fields = append(fields, fieldError{Field: "age", Message: "must not be negative"})
+	}
+
+	return fields
+}